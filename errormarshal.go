@@ -0,0 +1,81 @@
+package zerologr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorMarshaler controls how the error passed to LogSink.Error is rendered.
+// The default, nil, preserves the original behavior of writing err.Error()
+// as a flat string via zerolog's Err field. Set it to DefaultErrorMarshaler,
+// or a custom function, to emit a structured object instead.
+var ErrorMarshaler func(error) interface{}
+
+// kvProvider is implemented by errors that carry structured key/value
+// context, such as kverrors.KVError.
+type kvProvider interface {
+	KeysAndValues() []interface{}
+}
+
+// DefaultErrorMarshaler walks err's cause chain via errors.Unwrap and
+// renders it as a structured object, e.g.
+// {"msg": "failed to init db", "cause": {"msg": "failed to connect", "kv": {"host": "db1"}}}.
+// An error with no wrapped cause and no key/value context round-trips as
+// its plain Error() string.
+func DefaultErrorMarshaler(err error) interface{} {
+	return marshalError(err)
+}
+
+func marshalError(err error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	cause := errors.Unwrap(err)
+	kvp, hasKV := err.(kvProvider)
+
+	if cause == nil && !hasKV {
+		return err.Error()
+	}
+
+	msg := err.Error()
+	if cause != nil {
+		if trimmed := strings.TrimSuffix(msg, ": "+cause.Error()); trimmed != msg {
+			msg = trimmed
+		}
+	}
+
+	out := map[string]interface{}{"msg": msg}
+
+	if cause != nil {
+		out["cause"] = marshalError(cause)
+	}
+
+	if hasKV {
+		if kv := kvListToMap(kvp.KeysAndValues()); len(kv) > 0 {
+			out["kv"] = kv
+		}
+	}
+
+	return out
+}
+
+func kvListToMap(kvList []interface{}) map[string]interface{} {
+	if len(kvList)%2 != 0 {
+		return nil
+	}
+
+	m := make(map[string]interface{}, len(kvList)/2)
+
+	for i := 0; i < len(kvList); i += 2 {
+		key, ok := kvList[i].(string)
+		if !ok {
+			key = fmt.Sprintf("%v", kvList[i])
+		}
+
+		m[key] = kvList[i+1]
+	}
+
+	return m
+}
@@ -0,0 +1,60 @@
+package zerologr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tonglil/zerologr/kverrors"
+)
+
+func TestLogSink_Error_DefaultErrorMarshaler(t *testing.T) {
+	origErrorMarshaler := ErrorMarshaler
+	defer func() { ErrorMarshaler = origErrorMarshaler }()
+
+	ErrorMarshaler = DefaultErrorMarshaler
+
+	w := &bytes.Buffer{}
+	zl := zerolog.New(w)
+	log := New(&zl)
+
+	err := kverrors.Wrap(kverrors.New("failed to connect", "host", "db1"), "failed to init db")
+	log.Error(err, "error occurred")
+
+	var loggedLine map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Bytes(), &loggedLine))
+
+	errField, ok := loggedLine["error"].(map[string]interface{})
+	require.True(t, ok)
+
+	assert.Equal(t, "failed to init db", errField["msg"])
+
+	cause, ok := errField["cause"].(map[string]interface{})
+	require.True(t, ok)
+
+	assert.Equal(t, "failed to connect", cause["msg"])
+	assert.Equal(t, map[string]interface{}{"host": "db1"}, cause["kv"])
+}
+
+func TestLogSink_Error_DefaultErrorMarshaler_PlainError(t *testing.T) {
+	origErrorMarshaler := ErrorMarshaler
+	defer func() { ErrorMarshaler = origErrorMarshaler }()
+
+	ErrorMarshaler = DefaultErrorMarshaler
+
+	w := &bytes.Buffer{}
+	zl := zerolog.New(w)
+	log := New(&zl)
+
+	log.Error(errors.New("this is error"), "error occurred")
+
+	var loggedLine map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Bytes(), &loggedLine))
+
+	assert.Equal(t, "this is error", loggedLine["error"])
+}
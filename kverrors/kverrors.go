@@ -0,0 +1,70 @@
+// Package kverrors provides errors that carry structured key/value context
+// alongside a wrapped cause, in the spirit of ViaQ/logerr's kverrors
+// package. zerologr's default ErrorMarshaler recognizes errors produced
+// here and renders their cause chain and key/value context as a nested
+// object instead of a flat string.
+package kverrors
+
+import "errors"
+
+// KVError is an error with a message, an optional wrapped cause, and
+// optional key/value context.
+type KVError struct {
+	Msg   string
+	Cause error
+	KV    []interface{}
+}
+
+// Error implements error.
+func (e *KVError) Error() string {
+	if e.Cause == nil {
+		return e.Msg
+	}
+
+	if e.Msg == "" {
+		return e.Cause.Error()
+	}
+
+	return e.Msg + ": " + e.Cause.Error()
+}
+
+// Unwrap implements errors.Unwrap, making KVError compatible with
+// errors.Is/errors.As and zerologr's cause-chain walking.
+func (e *KVError) Unwrap() error {
+	return e.Cause
+}
+
+// KeysAndValues returns the key/value context attached to e.
+func (e *KVError) KeysAndValues() []interface{} {
+	return e.KV
+}
+
+// New returns a new error with msg and optional key/value context.
+func New(msg string, kv ...interface{}) error {
+	return &KVError{Msg: msg, KV: kv}
+}
+
+// Wrap returns a new error with msg and optional key/value context, wrapping
+// err as its cause.
+func Wrap(err error, msg string, kv ...interface{}) error {
+	return &KVError{Msg: msg, Cause: err, KV: kv}
+}
+
+// Add attaches kv to err without changing its message, wrapping err as the
+// cause of the returned error.
+func Add(err error, kv ...interface{}) error {
+	return &KVError{Cause: err, KV: kv}
+}
+
+// Root walks err's cause chain via errors.Unwrap and returns the innermost
+// error.
+func Root(err error) error {
+	for {
+		cause := errors.Unwrap(err)
+		if cause == nil {
+			return err
+		}
+
+		err = cause
+	}
+}
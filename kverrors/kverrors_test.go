@@ -0,0 +1,38 @@
+package kverrors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	err := New("failed to connect", "host", "db1")
+
+	assert.EqualError(t, err, "failed to connect")
+	assert.Equal(t, []interface{}{"host", "db1"}, err.(*KVError).KeysAndValues())
+}
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := Wrap(cause, "failed to connect", "host", "db1")
+
+	assert.EqualError(t, err, "failed to connect: connection refused")
+	assert.Equal(t, cause, errors.Unwrap(err))
+}
+
+func TestAdd(t *testing.T) {
+	cause := errors.New("connection refused")
+	err := Add(cause, "host", "db1")
+
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, []interface{}{"host", "db1"}, err.(*KVError).KeysAndValues())
+}
+
+func TestRoot(t *testing.T) {
+	root := errors.New("connection refused")
+	err := Wrap(Wrap(root, "failed to connect"), "failed to init db")
+
+	assert.Equal(t, root, Root(err))
+}
@@ -0,0 +1,98 @@
+package zerologr
+
+import (
+	"sync"
+)
+
+// Entry is a single structured log record, passed to every registered
+// observer. It mirrors the arguments a LogSink received, without requiring
+// observers to parse the rendered output.
+type Entry struct {
+	// Level is the zerolog level name the entry was emitted at, e.g. "info".
+	Level string
+	// Message is the log message.
+	Message string
+	// Name is the logger name set via WithName, or empty if unset.
+	Name string
+	// KeysAndValues are the merged WithValues and call-site key/value pairs.
+	// Nil if the call-site pairs couldn't be split into key/value pairs.
+	KeysAndValues []interface{}
+	// Error is the error passed to Error(), or nil for Info/Warn entries.
+	Error error
+	// Caller is a best-effort "file:line" for the log call site, empty if it
+	// could not be determined.
+	Caller string
+}
+
+// observerRegistry is a mutex-guarded, id-keyed set of observer callbacks,
+// shared by value-copy across a LogSink and every LogSink derived from it
+// via WithName/WithValues/WithCallDepth.
+type observerRegistry struct {
+	mu      sync.Mutex
+	nextID  int
+	entries map[int]func(Entry)
+}
+
+// add registers fn and returns a func that deregisters it.
+func (r *observerRegistry) add(fn func(Entry)) func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.entries == nil {
+		r.entries = make(map[int]func(Entry))
+	}
+
+	id := r.nextID
+	r.nextID++
+	r.entries[id] = fn
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		delete(r.entries, id)
+	}
+}
+
+// notify calls every observer with e, recovering and continuing if one
+// panics so a broken observer can never corrupt the emission it's watching.
+func (r *observerRegistry) notify(e Entry) {
+	r.mu.Lock()
+	observers := make([]func(Entry), 0, len(r.entries))
+	for _, fn := range r.entries {
+		observers = append(observers, fn)
+	}
+	r.mu.Unlock()
+
+	for _, fn := range observers {
+		callObserver(fn, e)
+	}
+}
+
+func callObserver(fn func(Entry), e Entry) {
+	defer func() { _ = recover() }()
+
+	fn(e)
+}
+
+// globalObservers back RegisterObserver; they run for every Entry emitted
+// by any LogSink, in addition to that sink's own observers.
+var globalObservers = &observerRegistry{}
+
+// RegisterObserver registers fn to be called with every Entry emitted by any
+// LogSink, regardless of which logger produced it. The returned func
+// deregisters fn; callers (especially tests) should call it once fn is no
+// longer needed, since globalObservers otherwise outlives any one LogSink.
+func RegisterObserver(fn func(Entry)) (remove func()) {
+	return globalObservers.add(fn)
+}
+
+// AddObserver registers fn to be called with every Entry this LogSink (and
+// any LogSink derived from it via WithName/WithValues/WithCallDepth) emits.
+func (ls *LogSink) AddObserver(fn func(Entry)) {
+	if ls.observers == nil {
+		ls.observers = &observerRegistry{}
+	}
+
+	ls.observers.add(fn)
+}
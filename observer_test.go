@@ -0,0 +1,68 @@
+package zerologr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSink_AddObserver(t *testing.T) {
+	t.Parallel()
+
+	logger, ts := NewTestSink()
+
+	var captured []Entry
+	sink, ok := logger.GetSink().(*LogSink)
+	require.True(t, ok)
+
+	sink.AddObserver(func(e Entry) {
+		captured = append(captured, e)
+	})
+
+	logger.WithName("main").WithValues("k1", "v1").Info("hello, world", "k2", "v2")
+	logger.Error(errors.New("boom"), "error occurred")
+
+	require.Len(t, captured, 2)
+	assert.Equal(t, "info", captured[0].Level)
+	assert.Equal(t, "hello, world", captured[0].Message)
+	assert.Equal(t, "main", captured[0].Name)
+	assert.Equal(t, []interface{}{"k1", "v1", "k2", "v2"}, captured[0].KeysAndValues)
+
+	assert.Equal(t, "error", captured[1].Level)
+	assert.EqualError(t, captured[1].Error, "boom")
+
+	require.Len(t, ts.Entries(), 2)
+}
+
+func TestLogSink_AddObserver_PanicRecovered(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := NewTestSink()
+
+	sink, ok := logger.GetSink().(*LogSink)
+	require.True(t, ok)
+
+	called := false
+	sink.AddObserver(func(Entry) { panic("boom") })
+	sink.AddObserver(func(Entry) { called = true })
+
+	assert.NotPanics(t, func() {
+		logger.Info("hello, world")
+	})
+	assert.True(t, called)
+}
+
+func TestRegisterObserver(t *testing.T) {
+	var captured Entry
+	remove := RegisterObserver(func(e Entry) {
+		captured = e
+	})
+	defer remove()
+
+	logger, _ := NewTestSink()
+	logger.Info("hello, global observer")
+
+	assert.Equal(t, "hello, global observer", captured.Message)
+}
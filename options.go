@@ -0,0 +1,140 @@
+package zerologr
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/rs/zerolog"
+)
+
+// Format selects the on-wire encoding used by NewWithOptions.
+type Format int
+
+const (
+	// FormatJSON writes one JSON object per log entry. This is zerolog's
+	// native, fastest format and is the default.
+	FormatJSON Format = iota
+	// FormatConsole writes colorized, human-friendly output suited to local
+	// development (via zerolog.ConsoleWriter).
+	FormatConsole
+	// FormatLogfmt writes `key=value` pairs, one log line per entry.
+	FormatLogfmt
+)
+
+// LevelFunc maps a logr V-level to the zerolog.Level it should be emitted
+// at. It replaces the default V(0)->Info, V(1)->Debug, V(2)->Trace ladder,
+// e.g. to map V(0)->Warn, or to widen V(3..5)->Trace for kubernetes-style
+// verbosity.
+type LevelFunc func(v int) zerolog.Level
+
+// DefaultLevelFunc is the LevelFunc used by NewWithOptions when
+// Options.LevelFunc is nil.
+func DefaultLevelFunc(v int) zerolog.Level {
+	switch v {
+	case 0:
+		return zerolog.InfoLevel
+	case 1:
+		return zerolog.DebugLevel
+	default:
+		return zerolog.TraceLevel
+	}
+}
+
+// Options configures a logr.Logger built by NewWithOptions.
+type Options struct {
+	// Writer is where log output is written. Defaults to os.Stderr.
+	Writer io.Writer
+
+	// Format selects the on-wire encoding. Defaults to FormatJSON.
+	Format Format
+
+	// Timestamp toggles whether a timestamp field is added to every entry.
+	Timestamp bool
+
+	// TimestampFormat overrides zerolog.TimeFieldFormat. Only used when
+	// Timestamp is true.
+	TimestampFormat string
+
+	// Verbosity is the initial verbosity threshold, passed through LevelFunc
+	// (or DefaultLevelFunc) to produce the underlying zerolog.Logger level.
+	Verbosity int
+
+	// ReportCaller toggles caller (file:line) reporting on every entry.
+	ReportCaller bool
+
+	// Name is the initial logger name, equivalent to calling WithName after
+	// construction.
+	Name string
+
+	// InitialKeysAndValues are initial key/value pairs, equivalent to
+	// calling WithValues after construction.
+	InitialKeysAndValues []interface{}
+
+	// LevelFunc overrides the default V->zerolog.Level mapping. Defaults to
+	// DefaultLevelFunc.
+	LevelFunc LevelFunc
+}
+
+// NewWithOptions builds the underlying zerolog.Logger from opts and returns
+// a logr.Logger backed by it, saving callers from having to assemble and
+// wrap a zerolog.Logger themselves.
+func NewWithOptions(opts Options) logr.Logger {
+	w := opts.Writer
+	if w == nil {
+		w = os.Stderr
+	}
+
+	switch opts.Format {
+	case FormatConsole:
+		w = zerolog.ConsoleWriter{Out: w}
+	case FormatLogfmt:
+		w = zerolog.ConsoleWriter{
+			Out:              w,
+			NoColor:          true,
+			FormatTimestamp:  func(i interface{}) string { return fmt.Sprintf("time=%v", i) },
+			FormatLevel:      func(i interface{}) string { return fmt.Sprintf("level=%v", i) },
+			FormatMessage:    func(i interface{}) string { return fmt.Sprintf("msg=%q", i) },
+			FormatCaller:     func(i interface{}) string { return fmt.Sprintf("caller=%v", i) },
+			FormatFieldName:  func(i interface{}) string { return fmt.Sprintf("%s=", i) },
+			FormatFieldValue: func(i interface{}) string { return fmt.Sprintf("%v", i) },
+		}
+	}
+
+	l := zerolog.New(w)
+
+	if opts.Timestamp {
+		if opts.TimestampFormat != "" {
+			zerolog.TimeFieldFormat = opts.TimestampFormat
+		}
+
+		l = l.With().Timestamp().Logger()
+	}
+
+	if opts.ReportCaller {
+		l = l.With().Caller().Logger()
+	}
+
+	levelFunc := opts.LevelFunc
+	if levelFunc == nil {
+		levelFunc = DefaultLevelFunc
+	}
+
+	l = l.Level(levelFunc(opts.Verbosity))
+
+	ls := NewLogSink(&l)
+	ls.levelFunc = levelFunc
+
+	logger := logr.New(ls)
+
+	if opts.Name != "" {
+		logger = logger.WithName(opts.Name)
+	}
+
+	if len(opts.InitialKeysAndValues) > 0 {
+		logger = logger.WithValues(opts.InitialKeysAndValues...)
+	}
+
+	return logger
+}
@@ -0,0 +1,113 @@
+package zerologr
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		opts       func(w *bytes.Buffer) Options
+		assertions map[string]string
+	}{
+		{
+			name: "defaults to JSON with info level",
+			opts: func(w *bytes.Buffer) Options {
+				return Options{Writer: w}
+			},
+			assertions: map[string]string{
+				"level":   "info",
+				"message": "hello, world",
+			},
+		},
+		{
+			name: "name and initial values are applied",
+			opts: func(w *bytes.Buffer) Options {
+				return Options{
+					Writer:               w,
+					Name:                 "main",
+					InitialKeysAndValues: []interface{}{"k1", "v1"},
+				}
+			},
+			assertions: map[string]string{
+				"level":   "info",
+				"message": "hello, world",
+				"logger":  "main",
+				"k1":      "v1",
+			},
+		},
+		{
+			name: "custom LevelFunc maps V(0) to warn",
+			opts: func(w *bytes.Buffer) Options {
+				return Options{
+					Writer: w,
+					LevelFunc: func(v int) zerolog.Level {
+						if v == 0 {
+							return zerolog.WarnLevel
+						}
+
+						return zerolog.InfoLevel
+					},
+				}
+			},
+			assertions: map[string]string{
+				"level":   "warn",
+				"message": "hello, world",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &bytes.Buffer{}
+			logger := NewWithOptions(tc.opts(w))
+
+			logger.Info("hello, world")
+
+			var loggedLine map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Bytes(), &loggedLine))
+
+			for k, v := range tc.assertions {
+				assert.Equal(t, v, loggedLine[k])
+			}
+		})
+	}
+}
+
+func TestNewWithOptions_Console(t *testing.T) {
+	t.Parallel()
+
+	w := &bytes.Buffer{}
+	logger := NewWithOptions(Options{Writer: w, Format: FormatConsole})
+
+	logger.Info("hello, world")
+
+	assert.Contains(t, w.String(), "hello, world")
+	assert.False(t, json.Valid(w.Bytes()), "console output should not be valid JSON")
+}
+
+func TestNewWithOptions_Logfmt(t *testing.T) {
+	t.Parallel()
+
+	w := &bytes.Buffer{}
+	logger := NewWithOptions(Options{Writer: w, Format: FormatLogfmt})
+
+	logger.Info("hello, world", "k1", "v1")
+
+	out := w.String()
+	assert.Contains(t, out, "level=info")
+	assert.Contains(t, out, `msg="hello, world"`)
+	assert.Contains(t, out, "k1=v1")
+	assert.False(t, json.Valid(w.Bytes()), "logfmt output should not be valid JSON")
+}
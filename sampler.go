@@ -0,0 +1,31 @@
+package zerologr
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/rs/zerolog"
+)
+
+// Sampler decides whether a given entry should be emitted. It's an alias
+// for zerolog.Sampler so callers can implement one without importing
+// zerolog themselves.
+type Sampler = zerolog.Sampler
+
+// BurstSampler is zerolog's BurstSampler, re-exported for the same reason as
+// Sampler. It allows Burst entries per Period before falling back to
+// NextSampler (nil means drop everything beyond the burst).
+type BurstSampler = zerolog.BurstSampler
+
+// WithSampler returns a logr.LogSink that applies sampler to every Info
+// call, to suppress duplicate V-logs from tight loops. Enabled(level)
+// remains deterministic; sampling only decides whether the write actually
+// happens. Error is never sampled, regardless of sampler.
+func (ls LogSink) WithSampler(sampler Sampler) logr.LogSink {
+	if ls.errLogger == nil {
+		ls.errLogger = ls.l
+	}
+
+	sampled := ls.l.Sample(sampler)
+	ls.l = &sampled
+
+	return &ls
+}
@@ -0,0 +1,78 @@
+package zerologr
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSink_WithSampler(t *testing.T) {
+	t.Parallel()
+
+	w := &bytes.Buffer{}
+	zl := zerolog.New(w)
+
+	sink := NewLogSink(&zl)
+	sampled, ok := sink.WithSampler(&BurstSampler{Burst: 1, Period: time.Hour}).(*LogSink)
+	require.True(t, ok)
+
+	logger := logr.New(sampled)
+
+	logger.Info("first")
+	logger.Info("second")
+	logger.Info("third")
+
+	lines := bytes.Count(w.Bytes(), []byte("\n"))
+	assert.Equal(t, 1, lines, "only the first entry within the burst should be written")
+}
+
+func TestLogSink_WithSampler_ErrorNeverSampled(t *testing.T) {
+	t.Parallel()
+
+	w := &bytes.Buffer{}
+	zl := zerolog.New(w)
+
+	sink := NewLogSink(&zl)
+	sampled, ok := sink.WithSampler(&BurstSampler{Burst: 0, Period: time.Hour}).(*LogSink)
+	require.True(t, ok)
+
+	logger := logr.New(sampled)
+
+	for i := 0; i < 5; i++ {
+		logger.Error(errors.New("boom"), "error occurred")
+	}
+
+	lines := bytes.Count(w.Bytes(), []byte("\n"))
+	assert.Equal(t, 5, lines, "Error must never be sampled out")
+}
+
+func BenchmarkInfo_Unsampled(b *testing.B) {
+	zl := zerolog.New(io.Discard)
+	logger := New(&zl).V(2)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		logger.Info("tight loop trace", "i", i)
+	}
+}
+
+func BenchmarkInfo_Sampled(b *testing.B) {
+	zl := zerolog.New(io.Discard)
+	sink := NewLogSink(&zl)
+	sampled := sink.WithSampler(&BurstSampler{Burst: 100, Period: time.Second})
+	logger := logr.New(sampled).V(2)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		logger.Info("tight loop trace", "i", i)
+	}
+}
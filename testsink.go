@@ -0,0 +1,44 @@
+package zerologr
+
+import (
+	"io"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/rs/zerolog"
+)
+
+// TestSink captures every Entry emitted through it instead of writing
+// rendered output anywhere, for use in tests that would otherwise unmarshal
+// a bytes.Buffer of JSON to make assertions.
+type TestSink struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+// NewTestSink returns a logr.Logger backed by a TestSink, and the TestSink
+// itself so the test can inspect ts.Entries().
+func NewTestSink() (logr.Logger, *TestSink) {
+	discard := zerolog.New(io.Discard)
+	sink := NewLogSink(&discard)
+
+	ts := &TestSink{}
+	sink.AddObserver(ts.capture)
+
+	return logr.New(sink), ts
+}
+
+// Entries returns the Entry values captured so far, in emission order.
+func (ts *TestSink) Entries() []Entry {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return append([]Entry{}, ts.entries...)
+}
+
+func (ts *TestSink) capture(e Entry) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	ts.entries = append(ts.entries, e)
+}
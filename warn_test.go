@@ -0,0 +1,81 @@
+package zerologr
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarnVerbosity(t *testing.T) {
+	// Not t.Parallel(): this test mutates the package-global WarnVerbosity,
+	// which would race with any other test reading or writing it
+	// concurrently.
+	origWarnVerbosity := WarnVerbosity
+	defer func() { WarnVerbosity = origWarnVerbosity }()
+
+	WarnVerbosity = 0
+
+	w := &bytes.Buffer{}
+	zl := zerolog.New(w)
+
+	ls := NewLogSink(&zl)
+	ls.Init(logr.RuntimeInfo{CallDepth: 1})
+
+	ls.Info(0, "disk usage high")
+
+	var loggedLine map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Bytes(), &loggedLine))
+
+	assert.Equal(t, "warn", loggedLine["level"])
+	assert.Equal(t, "disk usage high", loggedLine["message"])
+}
+
+func TestLogSink_Warn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		zerologLevel zerolog.Level
+		shown        bool
+	}{
+		{
+			name:         "shown when logger level allows warn",
+			zerologLevel: zerolog.InfoLevel,
+			shown:        true,
+		},
+		{
+			name:         "suppressed when logger level is error",
+			zerologLevel: zerolog.ErrorLevel,
+			shown:        false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &bytes.Buffer{}
+			zl := zerolog.New(w).Level(tc.zerologLevel)
+
+			ls := &LogSink{l: &zl}
+			ls.Warn("disk usage high")
+
+			if !tc.shown {
+				assert.Equal(t, 0, w.Len())
+				return
+			}
+
+			var loggedLine map[string]string
+			require.NoError(t, json.Unmarshal(w.Bytes(), &loggedLine))
+
+			assert.Equal(t, "warn", loggedLine["level"])
+			assert.Equal(t, "disk usage high", loggedLine["message"])
+		})
+	}
+}
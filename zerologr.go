@@ -0,0 +1,294 @@
+// Package zerologr implements github.com/go-logr/logr.Logger in terms of
+// Zerolog (github.com/rs/zerolog). This allows any code using logr.Logger to
+// output in Zerolog's structured, performant format.
+package zerologr
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/go-logr/logr"
+	"github.com/rs/zerolog"
+)
+
+// NameSeparator separates names set by `WithName` calls.
+var NameSeparator = "/"
+
+// NameFieldName is the field name used for the logger name.
+var NameFieldName = "logger"
+
+// VerbosityFieldName is the field name used for the logging V-level. Set to
+// the empty string to disable the field entirely.
+var VerbosityFieldName = "v"
+
+// ErrorFieldName is the field name used for the error passed to Error().
+var ErrorFieldName = "error"
+
+// WarnVerbosity is the V-level that maps to zerolog.WarnLevel instead of the
+// default Info/Debug/Trace ladder, e.g. setting WarnVerbosity = 0 makes
+// log.V(0).Info(...) emit at WarnLevel. The default, -1, disables this
+// (no V-level maps to Warn) since V() never produces a negative level. Only
+// consulted when a LogSink has no LevelFunc, so never for one built by
+// NewWithOptions, which always sets one (DefaultLevelFunc by default).
+var WarnVerbosity = -1
+
+// callerSkipFrameCount is the number of stack frames zerologr itself adds
+// between a LogSink method and zerolog's own event dispatch (where a
+// .Caller() hook actually resolves its skip count): logr.Logger.Info/Error
+// calling into LogSink.Info/Error/Warn, which calls ls.write. It's added to
+// zerolog.CallerSkipFrameCount once, at package init, so that .Caller()
+// reports the application's call site rather than a frame inside logr or
+// zerologr, regardless of whether it was requested before or after wrapping
+// a *zerolog.Logger with New.
+const callerSkipFrameCount = 3
+
+func init() {
+	zerolog.CallerSkipFrameCount += callerSkipFrameCount
+}
+
+var (
+	_ logr.LogSink          = &LogSink{}
+	_ logr.CallDepthLogSink = &LogSink{}
+)
+
+// New returns a logr.Logger which writes to the given zerolog.Logger.
+func New(l *zerolog.Logger) logr.Logger {
+	return logr.New(NewLogSink(l))
+}
+
+// NewLogSink returns a logr.LogSink which writes to the given zerolog.Logger.
+func NewLogSink(l *zerolog.Logger) *LogSink {
+	return &LogSink{
+		l: l,
+	}
+}
+
+// LogSink is a logr.LogSink that writes to a zerolog.Logger.
+type LogSink struct {
+	name      string
+	values    []interface{}
+	callDepth int
+	levelFunc LevelFunc
+	observers *observerRegistry
+
+	l *zerolog.Logger
+
+	// errLogger is the zerolog.Logger used by Error, bypassing any sampler
+	// installed via WithSampler. Nil unless WithSampler has been called, in
+	// which case it holds l as it was immediately before sampling.
+	errLogger *zerolog.Logger
+}
+
+// errorLogger returns the zerolog.Logger Error should write to.
+func (ls LogSink) errorLogger() *zerolog.Logger {
+	if ls.errLogger != nil {
+		return ls.errLogger
+	}
+
+	return ls.l
+}
+
+// Init implements logr.LogSink.
+func (ls *LogSink) Init(ri logr.RuntimeInfo) {
+	ls.callDepth = ri.CallDepth
+}
+
+// level returns the zerolog.Level that V(v) maps to, using ls.levelFunc if
+// set or the default Info/Debug/Trace ladder otherwise. The second return
+// value is false when v has no mapping and should never be enabled.
+func (ls LogSink) level(v int) (zerolog.Level, bool) {
+	if ls.levelFunc != nil {
+		return ls.levelFunc(v), true
+	}
+
+	if WarnVerbosity >= 0 && v == WarnVerbosity {
+		return zerolog.WarnLevel, true
+	}
+
+	switch v {
+	case 0:
+		return zerolog.InfoLevel, true
+	case 1:
+		return zerolog.DebugLevel, true
+	case 2:
+		return zerolog.TraceLevel, true
+	default:
+		return zerolog.NoLevel, false
+	}
+}
+
+// Enabled implements logr.LogSink.
+func (ls LogSink) Enabled(level int) bool {
+	zlevel, ok := ls.level(level)
+	if !ok {
+		return false
+	}
+
+	return ls.l.GetLevel() <= zlevel
+}
+
+// Info implements logr.LogSink.
+func (ls LogSink) Info(level int, msg string, kvList ...interface{}) {
+	if !ls.Enabled(level) {
+		return
+	}
+
+	zlevel, _ := ls.level(level)
+
+	e := ls.l.WithLevel(zlevel)
+	if e == nil {
+		return
+	}
+
+	if VerbosityFieldName != "" {
+		e = e.Int(VerbosityFieldName, level)
+	}
+
+	ls.write(e, zlevel, nil, msg, kvList)
+}
+
+// Error implements logr.LogSink.
+func (ls LogSink) Error(err error, msg string, kvList ...interface{}) {
+	e := ls.errorLogger().Error()
+	if e == nil {
+		return
+	}
+
+	if err != nil {
+		if ErrorMarshaler != nil {
+			e = e.Interface(ErrorFieldName, ErrorMarshaler(err))
+		} else {
+			e = e.AnErr(ErrorFieldName, err)
+		}
+	}
+
+	ls.write(e, zerolog.ErrorLevel, err, msg, kvList)
+}
+
+// Warner is an optional extension interface for logr.LogSink implementations
+// that can emit zerolog.WarnLevel directly, independent of the V-level
+// ladder. logr has no native concept of a warning level, so callers that
+// want one must type-assert for it, e.g.:
+//
+//	if w, ok := log.GetSink().(zerologr.Warner); ok {
+//		w.Warn("disk usage high", "percent", 92)
+//	}
+type Warner interface {
+	Warn(msg string, keysAndValues ...interface{})
+}
+
+var _ Warner = &LogSink{}
+
+// Warn logs msg at zerolog.WarnLevel, bypassing the V-level ladder.
+func (ls LogSink) Warn(msg string, kvList ...interface{}) {
+	if ls.l.GetLevel() > zerolog.WarnLevel {
+		return
+	}
+
+	e := ls.l.WithLevel(zerolog.WarnLevel)
+	if e == nil {
+		return
+	}
+
+	ls.write(e, zerolog.WarnLevel, nil, msg, kvList)
+}
+
+// WithName implements logr.LogSink.
+func (ls LogSink) WithName(name string) logr.LogSink {
+	if ls.name == "" {
+		ls.name = name
+	} else {
+		ls.name = ls.name + NameSeparator + name
+	}
+
+	return &ls
+}
+
+// WithValues implements logr.LogSink.
+func (ls LogSink) WithValues(kvList ...interface{}) logr.LogSink {
+	ls.values = append(copyValues(ls.values), kvList...)
+
+	return &ls
+}
+
+// WithCallDepth implements logr.CallDepthLogSink. The extra depth is baked
+// into its own caller hook on ls.l, rather than into the shared
+// zerolog.CallerSkipFrameCount, so concurrent LogSinks with different call
+// depths never race with each other.
+func (ls LogSink) WithCallDepth(depth int) logr.LogSink {
+	ls.callDepth += depth
+
+	l := ls.l.With().CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + depth).Logger()
+	ls.l = &l
+
+	return &ls
+}
+
+// write merges the sink's persisted name/values with kvList, notifies any
+// registered observers, and emits e.
+func (ls LogSink) write(e *zerolog.Event, zlevel zerolog.Level, err error, msg string, kvList []interface{}) {
+	if ls.name != "" {
+		e = e.Str(NameFieldName, ls.name)
+	}
+
+	merged := append(copyValues(ls.values), kvList...)
+	if len(merged)%2 != 0 {
+		merged = nil
+	}
+
+	handleFields(e, merged)
+
+	entry := Entry{
+		Level:         zlevel.String(),
+		Message:       msg,
+		Name:          ls.name,
+		KeysAndValues: merged,
+		Error:         err,
+		Caller:        callerFrame(ls.callDepth),
+	}
+
+	if ls.observers != nil {
+		ls.observers.notify(entry)
+	}
+
+	globalObservers.notify(entry)
+
+	e.Msg(msg)
+}
+
+// callerFrame returns a best-effort "file:line" for the original logging
+// call site. It skips its own frame, write's and the LogSink method's, then
+// depth more: logr.Logger's own frame (the CallDepth logr passes to Init)
+// plus any extra stack frames added by WithCallDepth.
+func callerFrame(depth int) string {
+	_, file, line, ok := runtime.Caller(3 + depth)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// handleFields writes kvList to e as key/value pairs. An odd-length kvList
+// cannot be split into pairs and is discarded entirely.
+func handleFields(e *zerolog.Event, kvList []interface{}) {
+	if len(kvList)%2 != 0 {
+		return
+	}
+
+	for i := 0; i < len(kvList); i += 2 {
+		key, ok := kvList[i].(string)
+		if !ok {
+			key = fmt.Sprintf("%v", kvList[i])
+		}
+
+		e.Interface(key, kvList[i+1])
+	}
+}
+
+func copyValues(values []interface{}) []interface{} {
+	out := make([]interface{}, len(values))
+	copy(out, values)
+
+	return out
+}